@@ -7,18 +7,51 @@ import (
 
 // App struct
 type App struct {
-	ctx        context.Context
+	ctx context.Context
+	// runner is always the kubectl binary, used directly for the
+	// streaming operations (log follow, watch) that ClientGoRunner does
+	// not implement. backend is the Runner selected for the regular
+	// list/get/delete/describe operations and may be runner itself or
+	// ClientGoRunner.
 	runner     *KubectlRunner
+	backend    Runner
 	transcript []CommandResult
 	mu         sync.Mutex
+
+	logStreams map[string]context.CancelFunc
+	streamSeq  int
+
+	watches  map[string]watchHandle
+	watchSeq int
+
+	portForwards map[string]*portForwardSession
+	forwardSeq   int
+
+	execSessions map[string]*execSession
+	execSeq      int
 }
 
 // NewApp creates a new App application struct
 func NewApp() *App {
+	runner := NewKubectlRunner()
 	return &App{
-		runner:     NewKubectlRunner(),
-		transcript: make([]CommandResult, 0, 200),
+		runner:       runner,
+		backend:      selectBackend(runner),
+		transcript:   make([]CommandResult, 0, 200),
+		logStreams:   make(map[string]context.CancelFunc),
+		watches:      make(map[string]watchHandle),
+		portForwards: make(map[string]*portForwardSession),
+		execSessions: make(map[string]*execSession),
+	}
+}
+
+// context returns the Wails runtime context if startup has run, or a
+// background context otherwise (e.g. when called from a test).
+func (a *App) context() context.Context {
+	if a.ctx == nil {
+		return context.Background()
 	}
+	return a.ctx
 }
 
 // startup is called when the app starts. The context is saved
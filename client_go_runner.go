@@ -0,0 +1,478 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// backendEnvVar selects the Runner backend App uses: "kubectl" forces
+// the shell-out path, "client-go" forces the API-server path (and
+// surfaces an error if no kubeconfig can be loaded, rather than
+// silently falling back), and anything else (including unset)
+// auto-detects, preferring client-go and falling back to kubectl if no
+// kubeconfig can be loaded.
+const backendEnvVar = "KUBECTL_UI_BACKEND"
+
+// selectBackend picks the Runner App uses for cluster operations,
+// honoring backendEnvVar and otherwise auto-detecting. It panics if
+// "client-go" is forced but no kubeconfig can be loaded, since that
+// means the explicit request can't be honored at all.
+func selectBackend(fallback Runner) Runner {
+	switch strings.ToLower(os.Getenv(backendEnvVar)) {
+	case "kubectl":
+		return fallback
+	case "client-go":
+		cgr, err := NewClientGoRunner()
+		if err != nil {
+			panic(fmt.Sprintf("%s=client-go was forced but no kubeconfig could be loaded: %v", backendEnvVar, err))
+		}
+		return cgr
+	default:
+		if cgr, err := NewClientGoRunner(); err == nil {
+			return cgr
+		}
+		return fallback
+	}
+}
+
+// ClientGoRunner implements Runner by talking to the Kubernetes API
+// server directly via client-go rather than shelling out to kubectl. It
+// avoids fork/exec overhead, honors context cancellation cleanly, and
+// lets callers distinguish apierrors.IsNotFound/IsForbidden, at the cost
+// of not supporting kubectl's `-f`/`-w` streaming (KubectlRunner.Stream
+// still backs StreamPodLogs and the watch subsystem for that).
+type ClientGoRunner struct {
+	loadingRules *clientcmd.ClientConfigLoadingRules
+
+	mu         sync.Mutex
+	clientsets map[string]*kubernetes.Clientset
+}
+
+// NewClientGoRunner builds a ClientGoRunner from the user's default
+// kubeconfig. It returns an error if no kubeconfig can be loaded, so
+// callers can fall back to KubectlRunner.
+func NewClientGoRunner() (*ClientGoRunner, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if _, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig(); err != nil {
+		return nil, fmt.Errorf("client-go: load kubeconfig: %w", err)
+	}
+	return &ClientGoRunner{
+		loadingRules: rules,
+		clientsets:   make(map[string]*kubernetes.Clientset),
+	}, nil
+}
+
+// ResetContext evicts the cached clientset for contextName, if any, so
+// the next call for it rebuilds from the kubeconfig on disk instead of
+// silently reusing a connection to whatever cluster used to be current.
+func (r *ClientGoRunner) ResetContext(contextName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.clientsets, contextName)
+}
+
+func (r *ClientGoRunner) clientsetFor(contextName string) (*kubernetes.Clientset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cs, ok := r.clientsets[contextName]; ok {
+		return cs, nil
+	}
+
+	overrides := &clientcmd.ConfigOverrides{}
+	if contextName != "" {
+		overrides.CurrentContext = contextName
+	}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(r.loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	cs, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	r.clientsets[contextName] = cs
+	return cs, nil
+}
+
+func (r *ClientGoRunner) ListNamespaces(ctx context.Context, contextName string) CommandResult {
+	start := time.Now()
+	path := "/api/v1/namespaces"
+
+	cs, err := r.clientsetFor(contextName)
+	if err != nil {
+		return apiResult("GET", path, start, err)
+	}
+	list, err := cs.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	result := apiResult("GET", path, start, err)
+	if err != nil {
+		return result
+	}
+
+	namespaces := make([]Namespace, 0, len(list.Items))
+	for _, ns := range list.Items {
+		namespaces = append(namespaces, Namespace{Name: ns.Name})
+	}
+	result.ParsedData = namespaces
+	return result
+}
+
+func (r *ClientGoRunner) ListPods(ctx context.Context, contextName, namespace string) CommandResult {
+	start := time.Now()
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods", namespace)
+
+	cs, err := r.clientsetFor(contextName)
+	if err != nil {
+		return apiResult("GET", path, start, err)
+	}
+	list, err := cs.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	result := apiResult("GET", path, start, err)
+	if err != nil {
+		return result
+	}
+
+	pods := make([]Pod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		pods = append(pods, podFromAPIPod(pod))
+	}
+	result.ParsedData = pods
+	return result
+}
+
+func (r *ClientGoRunner) DeletePod(ctx context.Context, contextName, namespace, name string) CommandResult {
+	start := time.Now()
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", namespace, name)
+
+	cs, err := r.clientsetFor(contextName)
+	if err != nil {
+		return apiResult("DELETE", path, start, err)
+	}
+	err = cs.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	return apiResult("DELETE", path, start, err)
+}
+
+func (r *ClientGoRunner) GetPodLogs(ctx context.Context, contextName, namespace, name, container string, tail int) CommandResult {
+	start := time.Now()
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log", namespace, name)
+
+	cs, err := r.clientsetFor(contextName)
+	if err != nil {
+		return apiResult("GET", path, start, err)
+	}
+
+	if tail <= 0 {
+		tail = 100
+	}
+	tailLines := int64(tail)
+	opts := &corev1.PodLogOptions{Container: container, TailLines: &tailLines}
+
+	stream, err := cs.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		return apiResult("GET", path, start, err)
+	}
+	defer stream.Close()
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, stream)
+	result := apiResult("GET", path, start, err)
+	result.Stdout = buf.String()
+	return result
+}
+
+func (r *ClientGoRunner) DescribePod(ctx context.Context, contextName, namespace, name string) CommandResult {
+	start := time.Now()
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", namespace, name)
+
+	cs, err := r.clientsetFor(contextName)
+	if err != nil {
+		return apiResult("GET", path, start, err)
+	}
+	pod, err := cs.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	result := apiResult("GET", path, start, err)
+	if err != nil {
+		return result
+	}
+
+	events := fetchPodEventsAPI(ctx, cs, namespace, name)
+	ownerChain := resolveOwnerChainAPI(ctx, cs, namespace, pod.OwnerReferences)
+
+	desc := podDescriptionFromAPIPod(*pod, ownerChain, events)
+	result.ParsedData = desc
+	result.Stdout = describePodPlainText(desc)
+	return result
+}
+
+func fetchPodEventsAPI(ctx context.Context, cs *kubernetes.Clientset, namespace, name string) []PodEventRecord {
+	selector := fields.Set{"involvedObject.name": name, "involvedObject.namespace": namespace}.AsSelector().String()
+	list, err := cs.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil
+	}
+
+	records := make([]PodEventRecord, 0, len(list.Items))
+	for _, e := range list.Items {
+		lastSeen := e.LastTimestamp.Time
+		if lastSeen.IsZero() {
+			lastSeen = e.EventTime.Time
+		}
+		count := int(e.Count)
+		if count == 0 {
+			count = 1
+		}
+		records = append(records, PodEventRecord{
+			LastSeen: lastSeen.Format(time.RFC3339),
+			Type:     e.Type,
+			Reason:   e.Reason,
+			Message:  e.Message,
+			Count:    count,
+		})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].LastSeen < records[j].LastSeen })
+	return records
+}
+
+// resolveOwnerChainAPI walks a pod's owner references one hop past
+// ReplicaSet to the Deployment that manages it, mirroring
+// KubectlRunner.resolveOwnerChain.
+func resolveOwnerChainAPI(ctx context.Context, cs *kubernetes.Clientset, namespace string, owners []metav1.OwnerReference) []OwnerRef {
+	chain := make([]OwnerRef, 0, len(owners)+1)
+	for _, o := range owners {
+		chain = append(chain, OwnerRef{Kind: o.Kind, Name: o.Name})
+		if o.Kind != "ReplicaSet" {
+			continue
+		}
+		rs, err := cs.AppsV1().ReplicaSets(namespace).Get(ctx, o.Name, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		for _, rsOwner := range rs.OwnerReferences {
+			chain = append(chain, OwnerRef{Kind: rsOwner.Kind, Name: rsOwner.Name})
+		}
+	}
+	return chain
+}
+
+// podDescriptionFromAPIPod builds the same PodDescription shape
+// buildPodDescription assembles from kubectl JSON, directly from
+// client-go's typed corev1.Pod.
+func podDescriptionFromAPIPod(pod corev1.Pod, ownerChain []OwnerRef, events []PodEventRecord) PodDescription {
+	statusByName := make(map[string]corev1.ContainerStatus, len(pod.Status.ContainerStatuses))
+	for _, cs := range pod.Status.ContainerStatuses {
+		statusByName[cs.Name] = cs
+	}
+
+	containers := make([]ContainerDetail, 0, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		cs := statusByName[c.Name]
+
+		env := make([]EnvVar, 0, len(c.Env))
+		for _, e := range c.Env {
+			env = append(env, EnvVar{Name: e.Name, Value: e.Value})
+		}
+
+		mounts := make([]VolumeMount, 0, len(c.VolumeMounts))
+		for _, m := range c.VolumeMounts {
+			mounts = append(mounts, VolumeMount{Name: m.Name, MountPath: m.MountPath, ReadOnly: m.ReadOnly})
+		}
+
+		containers = append(containers, ContainerDetail{
+			Name:         c.Name,
+			Image:        c.Image,
+			Ready:        cs.Ready,
+			RestartCount: int(cs.RestartCount),
+			Resources: ContainerResources{
+				Requests: resourceListToStrings(c.Resources.Requests),
+				Limits:   resourceListToStrings(c.Resources.Limits),
+			},
+			Env:          env,
+			VolumeMounts: mounts,
+			Probes: ContainerProbes{
+				Liveness:  describeAPIProbe(c.LivenessProbe),
+				Readiness: describeAPIProbe(c.ReadinessProbe),
+				Startup:   describeAPIProbe(c.StartupProbe),
+			},
+		})
+	}
+
+	tolerations := make([]Toleration, 0, len(pod.Spec.Tolerations))
+	for _, t := range pod.Spec.Tolerations {
+		tolerations = append(tolerations, Toleration{Key: t.Key, Operator: string(t.Operator), Value: t.Value, Effect: string(t.Effect)})
+	}
+
+	conditions := make([]PodCondition, 0, len(pod.Status.Conditions))
+	for _, c := range pod.Status.Conditions {
+		conditions = append(conditions, PodCondition{Type: string(c.Type), Status: string(c.Status), Reason: c.Reason})
+	}
+
+	volumes := make([]VolumeDetail, 0, len(pod.Spec.Volumes))
+	for _, v := range pod.Spec.Volumes {
+		volumes = append(volumes, VolumeDetail{Name: v.Name, Source: volumeSourceKind(v.VolumeSource)})
+	}
+
+	return PodDescription{
+		Name:        pod.Name,
+		Namespace:   pod.Namespace,
+		Node:        pod.Spec.NodeName,
+		Labels:      pod.Labels,
+		Annotations: pod.Annotations,
+		OwnerChain:  ownerChain,
+		Containers:  containers,
+		Volumes:     volumes,
+		Tolerations: tolerations,
+		Conditions:  conditions,
+		Events:      events,
+	}
+}
+
+func resourceListToStrings(rl corev1.ResourceList) map[string]string {
+	if len(rl) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(rl))
+	for name, qty := range rl {
+		out[string(name)] = qty.String()
+	}
+	return out
+}
+
+func describeAPIProbe(p *corev1.Probe) string {
+	switch {
+	case p == nil:
+		return ""
+	case p.Exec != nil:
+		return "exec: " + strings.Join(p.Exec.Command, " ")
+	case p.HTTPGet != nil:
+		return fmt.Sprintf("httpGet :%s%s", p.HTTPGet.Port.String(), p.HTTPGet.Path)
+	case p.TCPSocket != nil:
+		return fmt.Sprintf("tcpSocket :%s", p.TCPSocket.Port.String())
+	}
+	return ""
+}
+
+func volumeSourceKind(vs corev1.VolumeSource) string {
+	switch {
+	case vs.ConfigMap != nil:
+		return "configMap"
+	case vs.Secret != nil:
+		return "secret"
+	case vs.EmptyDir != nil:
+		return "emptyDir"
+	case vs.HostPath != nil:
+		return "hostPath"
+	case vs.PersistentVolumeClaim != nil:
+		return "persistentVolumeClaim"
+	case vs.Projected != nil:
+		return "projected"
+	default:
+		return "unknown"
+	}
+}
+
+// apiResult adapts a client-go call into the CommandResult transport,
+// synthesizing a Command string that reads like the HTTP request the
+// call made so the transcript view still has something to show.
+func apiResult(method, path string, start time.Time, err error) CommandResult {
+	result := CommandResult{
+		Command:    method + " " + path,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.ExitCode = -1
+		result.Stderr = describeAPIError(err)
+	}
+	return result
+}
+
+func describeAPIError(err error) string {
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Sprintf("not found: %v", err)
+	case apierrors.IsForbidden(err):
+		return fmt.Sprintf("forbidden: %v", err)
+	default:
+		return err.Error()
+	}
+}
+
+// podItemFromAPIPod converts a client-go Pod into the same podItem shape
+// parsePods decodes from kubectl JSON output, so derivePodStatus and
+// podFromItem stay the single source of truth for status derivation
+// regardless of which backend fetched the pod.
+func podItemFromAPIPod(pod corev1.Pod) podItem {
+	var item podItem
+	item.Metadata.Name = pod.Name
+	item.Metadata.CreationTimestamp = pod.CreationTimestamp.Time
+	if pod.DeletionTimestamp != nil {
+		t := pod.DeletionTimestamp.Time
+		item.Metadata.DeletionTimestamp = &t
+	}
+	for _, ref := range pod.OwnerReferences {
+		item.Metadata.OwnerReferences = append(item.Metadata.OwnerReferences, struct {
+			Kind string `json:"kind"`
+		}{Kind: ref.Kind})
+	}
+
+	for _, ic := range pod.Spec.InitContainers {
+		item.Spec.InitContainers = append(item.Spec.InitContainers, struct {
+			Name string `json:"name"`
+		}{Name: ic.Name})
+	}
+
+	item.Status.Phase = string(pod.Status.Phase)
+	item.Status.Reason = pod.Status.Reason
+	item.Status.NodeName = pod.Spec.NodeName
+	for _, cs := range pod.Status.InitContainerStatuses {
+		item.Status.InitContainerStatuses = append(item.Status.InitContainerStatuses, containerStatusItemFromAPI(cs))
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		item.Status.ContainerStatuses = append(item.Status.ContainerStatuses, containerStatusItemFromAPI(cs))
+	}
+	return item
+}
+
+func containerStatusItemFromAPI(cs corev1.ContainerStatus) containerStatusItem {
+	var out containerStatusItem
+	out.Name = cs.Name
+	out.Ready = cs.Ready
+	out.RestartCount = int(cs.RestartCount)
+	if cs.State.Waiting != nil {
+		out.State.Waiting = &struct {
+			Reason string `json:"reason"`
+		}{Reason: cs.State.Waiting.Reason}
+	}
+	if cs.State.Running != nil {
+		out.State.Running = &struct {
+			StartedAt time.Time `json:"startedAt"`
+		}{StartedAt: cs.State.Running.StartedAt.Time}
+	}
+	if cs.State.Terminated != nil {
+		out.State.Terminated = &struct {
+			Reason   string `json:"reason"`
+			ExitCode int    `json:"exitCode"`
+			Signal   int    `json:"signal"`
+		}{
+			Reason:   cs.State.Terminated.Reason,
+			ExitCode: int(cs.State.Terminated.ExitCode),
+			Signal:   int(cs.State.Terminated.Signal),
+		}
+	}
+	return out
+}
+
+func podFromAPIPod(pod corev1.Pod) Pod {
+	return podFromItem(podItemFromAPIPod(pod))
+}
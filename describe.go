@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// This file replaces DescribePod's old raw `kubectl describe` passthrough
+// with a structured describer: it fetches the pod JSON plus its recent
+// Events, resolves the owner chain (e.g. ReplicaSet -> Deployment), and
+// assembles a PodDescription so the UI can render a rich detail pane
+// instead of a monospace blob. Stdout still carries a short plain-text
+// summary as a fallback for callers that only look at raw output.
+
+type ownerRefItem struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+type probeItem struct {
+	Exec *struct {
+		Command []string `json:"command"`
+	} `json:"exec"`
+	HTTPGet *struct {
+		Path string      `json:"path"`
+		Port interface{} `json:"port"`
+	} `json:"httpGet"`
+	TCPSocket *struct {
+		Port interface{} `json:"port"`
+	} `json:"tcpSocket"`
+}
+
+func (p *probeItem) describe() string {
+	switch {
+	case p == nil:
+		return ""
+	case p.Exec != nil:
+		return "exec: " + strings.Join(p.Exec.Command, " ")
+	case p.HTTPGet != nil:
+		return fmt.Sprintf("httpGet :%v%s", p.HTTPGet.Port, p.HTTPGet.Path)
+	case p.TCPSocket != nil:
+		return fmt.Sprintf("tcpSocket :%v", p.TCPSocket.Port)
+	}
+	return ""
+}
+
+type describeContainerItem struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	Env   []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"env"`
+	Resources struct {
+		Requests map[string]string `json:"requests"`
+		Limits   map[string]string `json:"limits"`
+	} `json:"resources"`
+	VolumeMounts []struct {
+		Name      string `json:"name"`
+		MountPath string `json:"mountPath"`
+		ReadOnly  bool   `json:"readOnly"`
+	} `json:"volumeMounts"`
+	LivenessProbe  *probeItem `json:"livenessProbe"`
+	ReadinessProbe *probeItem `json:"readinessProbe"`
+	StartupProbe   *probeItem `json:"startupProbe"`
+}
+
+type describePodItem struct {
+	Metadata struct {
+		Name            string            `json:"name"`
+		Namespace       string            `json:"namespace"`
+		Labels          map[string]string `json:"labels"`
+		Annotations     map[string]string `json:"annotations"`
+		OwnerReferences []ownerRefItem    `json:"ownerReferences"`
+	} `json:"metadata"`
+	Spec struct {
+		NodeName    string                  `json:"nodeName"`
+		Containers  []describeContainerItem `json:"containers"`
+		Volumes     []json.RawMessage       `json:"volumes"`
+		Tolerations []struct {
+			Key      string `json:"key"`
+			Operator string `json:"operator"`
+			Value    string `json:"value"`
+			Effect   string `json:"effect"`
+		} `json:"tolerations"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []struct {
+			Type   string `json:"type"`
+			Status string `json:"status"`
+			Reason string `json:"reason"`
+		} `json:"conditions"`
+		ContainerStatuses []containerStatusItem `json:"containerStatuses"`
+	} `json:"status"`
+}
+
+type eventItem struct {
+	LastTimestamp time.Time `json:"lastTimestamp"`
+	EventTime     time.Time `json:"eventTime"`
+	Type          string    `json:"type"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Count         int       `json:"count"`
+}
+
+type eventList struct {
+	Items []eventItem `json:"items"`
+}
+
+func (r *KubectlRunner) DescribePod(ctx context.Context, contextName, namespace, name string) CommandResult {
+	podArgs, err := withContext([]string{"get", "pod", name, "-n", namespace, "-o", "json"}, contextName)
+	if err != nil {
+		return invalidResult([]string{"--context", contextName, "describe", "pod", name, "-n", namespace}, err)
+	}
+	result := r.Run(ctx, podArgs, defaultTimeout)
+	if result.ExitCode != 0 {
+		return result
+	}
+
+	var item describePodItem
+	if err := json.Unmarshal([]byte(result.Stdout), &item); err != nil {
+		appendParseError(&result, err)
+		return result
+	}
+
+	events := r.fetchPodEvents(ctx, contextName, namespace, name, &result)
+	ownerChain := r.resolveOwnerChain(ctx, contextName, namespace, item.Metadata.OwnerReferences)
+
+	desc := buildPodDescription(item, ownerChain, events)
+	result.ParsedData = desc
+	result.Stdout = describePodPlainText(desc)
+	return result
+}
+
+func (r *KubectlRunner) fetchPodEvents(ctx context.Context, contextName, namespace, name string, result *CommandResult) []PodEventRecord {
+	selector := fmt.Sprintf("involvedObject.name=%s,involvedObject.namespace=%s", name, namespace)
+	args, err := withContext([]string{"get", "events", "--field-selector", selector, "-n", namespace, "-o", "json"}, contextName)
+	if err != nil {
+		appendParseError(result, err)
+		return nil
+	}
+	eventResult := r.Run(ctx, args, defaultTimeout)
+	if eventResult.ExitCode != 0 {
+		return nil
+	}
+	events, err := parsePodEvents(eventResult.Stdout)
+	if err != nil {
+		appendParseError(result, err)
+		return nil
+	}
+	return events
+}
+
+// resolveOwnerChain walks a pod's owner references one hop past
+// ReplicaSet to the Deployment that manages it, which kubectl's own
+// describe output does as well.
+func (r *KubectlRunner) resolveOwnerChain(ctx context.Context, contextName, namespace string, owners []ownerRefItem) []OwnerRef {
+	chain := make([]OwnerRef, 0, len(owners)+1)
+	for _, o := range owners {
+		chain = append(chain, OwnerRef{Kind: o.Kind, Name: o.Name})
+		if o.Kind != "ReplicaSet" {
+			continue
+		}
+		args, err := withContext([]string{"get", "replicaset", o.Name, "-n", namespace, "-o", "json"}, contextName)
+		if err != nil {
+			continue
+		}
+		result := r.Run(ctx, args, defaultTimeout)
+		if result.ExitCode != 0 {
+			continue
+		}
+		var rs struct {
+			Metadata struct {
+				OwnerReferences []ownerRefItem `json:"ownerReferences"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal([]byte(result.Stdout), &rs); err != nil {
+			continue
+		}
+		for _, rsOwner := range rs.Metadata.OwnerReferences {
+			chain = append(chain, OwnerRef{Kind: rsOwner.Kind, Name: rsOwner.Name})
+		}
+	}
+	return chain
+}
+
+func parsePodEvents(stdout string) ([]PodEventRecord, error) {
+	var list eventList
+	if err := json.Unmarshal([]byte(stdout), &list); err != nil {
+		return nil, err
+	}
+	records := make([]PodEventRecord, 0, len(list.Items))
+	for _, e := range list.Items {
+		lastSeen := e.LastTimestamp
+		if lastSeen.IsZero() {
+			lastSeen = e.EventTime
+		}
+		count := e.Count
+		if count == 0 {
+			count = 1
+		}
+		records = append(records, PodEventRecord{
+			LastSeen: lastSeen.Format(time.RFC3339),
+			Type:     e.Type,
+			Reason:   e.Reason,
+			Message:  e.Message,
+			Count:    count,
+		})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].LastSeen < records[j].LastSeen })
+	return records, nil
+}
+
+// parseVolumes extracts each volume's name plus the kind of source that
+// backs it (its only other top-level key, e.g. "configMap"/"emptyDir").
+func parseVolumes(raw []json.RawMessage) []VolumeDetail {
+	volumes := make([]VolumeDetail, 0, len(raw))
+	for _, r := range raw {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(r, &fields); err != nil {
+			continue
+		}
+		var name string
+		if n, ok := fields["name"]; ok {
+			json.Unmarshal(n, &name)
+		}
+		source := "unknown"
+		for key := range fields {
+			if key == "name" {
+				continue
+			}
+			source = key
+			break
+		}
+		volumes = append(volumes, VolumeDetail{Name: name, Source: source})
+	}
+	return volumes
+}
+
+func buildPodDescription(item describePodItem, ownerChain []OwnerRef, events []PodEventRecord) PodDescription {
+	statusByName := make(map[string]containerStatusItem, len(item.Status.ContainerStatuses))
+	for _, cs := range item.Status.ContainerStatuses {
+		statusByName[cs.Name] = cs
+	}
+
+	containers := make([]ContainerDetail, 0, len(item.Spec.Containers))
+	for _, c := range item.Spec.Containers {
+		cs := statusByName[c.Name]
+
+		env := make([]EnvVar, 0, len(c.Env))
+		for _, e := range c.Env {
+			env = append(env, EnvVar{Name: e.Name, Value: e.Value})
+		}
+
+		mounts := make([]VolumeMount, 0, len(c.VolumeMounts))
+		for _, m := range c.VolumeMounts {
+			mounts = append(mounts, VolumeMount{Name: m.Name, MountPath: m.MountPath, ReadOnly: m.ReadOnly})
+		}
+
+		containers = append(containers, ContainerDetail{
+			Name:         c.Name,
+			Image:        c.Image,
+			Ready:        cs.Ready,
+			RestartCount: cs.RestartCount,
+			Resources: ContainerResources{
+				Requests: c.Resources.Requests,
+				Limits:   c.Resources.Limits,
+			},
+			Env:          env,
+			VolumeMounts: mounts,
+			Probes: ContainerProbes{
+				Liveness:  c.LivenessProbe.describe(),
+				Readiness: c.ReadinessProbe.describe(),
+				Startup:   c.StartupProbe.describe(),
+			},
+		})
+	}
+
+	tolerations := make([]Toleration, 0, len(item.Spec.Tolerations))
+	for _, t := range item.Spec.Tolerations {
+		tolerations = append(tolerations, Toleration{Key: t.Key, Operator: t.Operator, Value: t.Value, Effect: t.Effect})
+	}
+
+	conditions := make([]PodCondition, 0, len(item.Status.Conditions))
+	for _, c := range item.Status.Conditions {
+		conditions = append(conditions, PodCondition{Type: c.Type, Status: c.Status, Reason: c.Reason})
+	}
+
+	return PodDescription{
+		Name:        item.Metadata.Name,
+		Namespace:   item.Metadata.Namespace,
+		Node:        item.Spec.NodeName,
+		Labels:      item.Metadata.Labels,
+		Annotations: item.Metadata.Annotations,
+		OwnerChain:  ownerChain,
+		Containers:  containers,
+		Volumes:     parseVolumes(item.Spec.Volumes),
+		Tolerations: tolerations,
+		Conditions:  conditions,
+		Events:      events,
+	}
+}
+
+// describePodPlainText renders a compact text fallback from a
+// PodDescription, shared by both the kubectl and client-go backends.
+func describePodPlainText(desc PodDescription) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name:      %s\n", desc.Name)
+	fmt.Fprintf(&b, "Namespace: %s\n", desc.Namespace)
+	fmt.Fprintf(&b, "Node:      %s\n", desc.Node)
+	for _, o := range desc.OwnerChain {
+		fmt.Fprintf(&b, "Owner:     %s/%s\n", o.Kind, o.Name)
+	}
+	for _, c := range desc.Containers {
+		fmt.Fprintf(&b, "Container: %s (%s)\n", c.Name, c.Image)
+	}
+	fmt.Fprintf(&b, "Events:    %d\n", len(desc.Events))
+	return b.String()
+}
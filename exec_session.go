@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/creack/pty"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type execSession struct {
+	cmd    *exec.Cmd
+	pty    *os.File
+	cancel context.CancelFunc
+}
+
+// Exec starts `kubectl exec -it` against pod/container and returns a
+// session ID. Output is streamed through "exec:<id>:data" runtime events
+// for the frontend to feed into an xterm.js terminal; ExecInput and
+// ExecResize carry keystrokes and terminal resizes back to the session.
+func (a *App) Exec(contextName, namespace, pod, container string, command []string) (string, error) {
+	if err := validateNamespace(namespace); err != nil {
+		return "", err
+	}
+	if err := validatePodName(pod); err != nil {
+		return "", err
+	}
+	if container != "" {
+		if err := validateContainerName(container); err != nil {
+			return "", err
+		}
+	}
+	if len(command) == 0 {
+		command = []string{"/bin/sh"}
+	}
+
+	args := []string{"exec", "-it", pod, "-n", namespace}
+	if container != "" {
+		args = append(args, "-c", container)
+	}
+	args = append(args, "--")
+	args = append(args, command...)
+	args, err := withContext(args, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithCancel(a.context())
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+
+	ptmx, err := pty.Start(cmd)
+	if err != nil {
+		cancel()
+		return "", err
+	}
+
+	a.mu.Lock()
+	a.execSeq++
+	id := fmt.Sprintf("exec-%d", a.execSeq)
+	a.execSessions[id] = &execSession{cmd: cmd, pty: ptmx, cancel: cancel}
+	a.mu.Unlock()
+
+	go a.streamExecOutput(id, ptmx, cmd)
+
+	return id, nil
+}
+
+func (a *App) streamExecOutput(id string, ptmx *os.File, cmd *exec.Cmd) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := ptmx.Read(buf)
+		if n > 0 {
+			runtime.EventsEmit(a.ctx, "exec:"+id+":data", string(buf[:n]))
+		}
+		if err != nil {
+			break
+		}
+	}
+	err := cmd.Wait()
+
+	a.mu.Lock()
+	delete(a.execSessions, id)
+	a.mu.Unlock()
+
+	ended := ExecEnded{SessionID: id}
+	if err != nil {
+		ended.Err = err.Error()
+	}
+	runtime.EventsEmit(a.ctx, "exec:"+id+":ended", ended)
+}
+
+// ExecInput sends keystrokes typed into the frontend's terminal to the
+// exec session's pty.
+func (a *App) ExecInput(sessionID, data string) error {
+	sess, err := a.lookupExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+	_, err = sess.pty.Write([]byte(data))
+	return err
+}
+
+// ExecResize resizes the exec session's pty to match the frontend
+// terminal's dimensions.
+func (a *App) ExecResize(sessionID string, cols, rows int) error {
+	sess, err := a.lookupExecSession(sessionID)
+	if err != nil {
+		return err
+	}
+	return pty.Setsize(sess.pty, &pty.Winsize{Cols: uint16(cols), Rows: uint16(rows)})
+}
+
+// StopExec ends a running exec session. It is a no-op if the session has
+// already ended.
+func (a *App) StopExec(sessionID string) {
+	a.mu.Lock()
+	sess, ok := a.execSessions[sessionID]
+	delete(a.execSessions, sessionID)
+	a.mu.Unlock()
+
+	if ok {
+		sess.cancel()
+	}
+}
+
+func (a *App) lookupExecSession(sessionID string) (*execSession, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	sess, ok := a.execSessions[sessionID]
+	if !ok {
+		return nil, fmt.Errorf("unknown exec session %q", sessionID)
+	}
+	return sess, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// This file implements Runner on top of KubectlRunner by shelling out to
+// kubectl, the same argument building and parsing that used to live
+// directly on App before the client-go backend was introduced.
+// DescribePod lives in describe.go since it does more than build one
+// command.
+
+func (r *KubectlRunner) ListNamespaces(ctx context.Context, contextName string) CommandResult {
+	args, err := withContext([]string{"get", "ns", "-o", "json"}, contextName)
+	if err != nil {
+		return invalidResult([]string{"--context", contextName, "get", "ns", "-o", "json"}, err)
+	}
+	result := r.Run(ctx, args, defaultTimeout)
+	if result.ExitCode == 0 {
+		parsed, err := parseNamespaces(result.Stdout)
+		if err != nil {
+			appendParseError(&result, err)
+		} else {
+			result.ParsedData = parsed
+		}
+	}
+	return result
+}
+
+func (r *KubectlRunner) ListPods(ctx context.Context, contextName, namespace string) CommandResult {
+	args, err := withContext([]string{"get", "pods", "-n", namespace, "-o", "json"}, contextName)
+	if err != nil {
+		return invalidResult([]string{"--context", contextName, "get", "pods", "-n", namespace, "-o", "json"}, err)
+	}
+	result := r.Run(ctx, args, defaultTimeout)
+	if result.ExitCode == 0 {
+		parsed, err := parsePods(result.Stdout)
+		if err != nil {
+			appendParseError(&result, err)
+		} else {
+			result.ParsedData = parsed
+		}
+	}
+	return result
+}
+
+func (r *KubectlRunner) DeletePod(ctx context.Context, contextName, namespace, name string) CommandResult {
+	args, err := withContext([]string{"delete", "pod", name, "-n", namespace}, contextName)
+	if err != nil {
+		return invalidResult([]string{"--context", contextName, "delete", "pod", name, "-n", namespace}, err)
+	}
+	return r.Run(ctx, args, defaultTimeout)
+}
+
+// ResetContext is a no-op: KubectlRunner caches nothing per context, so
+// every call already re-reads the kubeconfig's current-context fresh.
+func (r *KubectlRunner) ResetContext(contextName string) {}
+
+func (r *KubectlRunner) GetPodLogs(ctx context.Context, contextName, namespace, name, container string, tail int) CommandResult {
+	if tail <= 0 {
+		tail = 100
+	}
+	logArgs := []string{"logs", name, "-n", namespace, fmt.Sprintf("--tail=%d", tail)}
+	if container != "" {
+		logArgs = append(logArgs, "-c", container)
+	}
+	args, err := withContext(logArgs, contextName)
+	if err != nil {
+		return invalidResult([]string{"--context", contextName, "logs", name, "-n", namespace}, err)
+	}
+	return r.Run(ctx, args, logsTimeout)
+}
@@ -6,41 +6,60 @@ import (
 	"time"
 )
 
+type namespaceItem struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+}
+
 type namespaceList struct {
-	Items []struct {
-		Metadata struct {
+	Items []namespaceItem `json:"items"`
+}
+
+type containerStatusItem struct {
+	Name         string `json:"name"`
+	Ready        bool   `json:"ready"`
+	RestartCount int    `json:"restartCount"`
+	State        struct {
+		Waiting *struct {
+			Reason string `json:"reason"`
+		} `json:"waiting"`
+		Running *struct {
+			StartedAt time.Time `json:"startedAt"`
+		} `json:"running"`
+		Terminated *struct {
+			Reason   string `json:"reason"`
+			ExitCode int    `json:"exitCode"`
+			Signal   int    `json:"signal"`
+		} `json:"terminated"`
+	} `json:"state"`
+}
+
+type podItem struct {
+	Metadata struct {
+		Name              string     `json:"name"`
+		CreationTimestamp time.Time  `json:"creationTimestamp"`
+		DeletionTimestamp *time.Time `json:"deletionTimestamp"`
+		OwnerReferences   []struct {
+			Kind string `json:"kind"`
+		} `json:"ownerReferences"`
+	} `json:"metadata"`
+	Spec struct {
+		InitContainers []struct {
 			Name string `json:"name"`
-		} `json:"metadata"`
-	} `json:"items"`
+		} `json:"initContainers"`
+	} `json:"spec"`
+	Status struct {
+		Phase                 string                `json:"phase"`
+		Reason                string                `json:"reason"`
+		NodeName              string                `json:"nodeName"`
+		InitContainerStatuses []containerStatusItem `json:"initContainerStatuses"`
+		ContainerStatuses     []containerStatusItem `json:"containerStatuses"`
+	} `json:"status"`
 }
 
 type podList struct {
-	Items []struct {
-		Metadata struct {
-			Name              string    `json:"name"`
-			CreationTimestamp time.Time `json:"creationTimestamp"`
-			OwnerReferences   []struct {
-				Kind string `json:"kind"`
-			} `json:"ownerReferences"`
-		} `json:"metadata"`
-		Status struct {
-			Phase             string `json:"phase"`
-			Reason            string `json:"reason"`
-			NodeName          string `json:"nodeName"`
-			ContainerStatuses []struct {
-				Ready        bool `json:"ready"`
-				RestartCount int  `json:"restartCount"`
-				State        struct {
-					Waiting *struct {
-						Reason string `json:"reason"`
-					} `json:"waiting"`
-					Terminated *struct {
-						Reason string `json:"reason"`
-					} `json:"terminated"`
-				} `json:"state"`
-			} `json:"containerStatuses"`
-		} `json:"status"`
-	} `json:"items"`
+	Items []podItem `json:"items"`
 }
 
 type kubeConfigView struct {
@@ -49,6 +68,13 @@ type kubeConfigView struct {
 	} `json:"contexts"`
 }
 
+func namespaceFromItem(item namespaceItem) (Namespace, bool) {
+	if item.Metadata.Name == "" {
+		return Namespace{}, false
+	}
+	return Namespace{Name: item.Metadata.Name}, true
+}
+
 func parseNamespaces(stdout string) ([]Namespace, error) {
 	var list namespaceList
 	if err := json.Unmarshal([]byte(stdout), &list); err != nil {
@@ -56,58 +82,129 @@ func parseNamespaces(stdout string) ([]Namespace, error) {
 	}
 	namespaces := make([]Namespace, 0, len(list.Items))
 	for _, item := range list.Items {
-		if item.Metadata.Name == "" {
-			continue
+		if ns, ok := namespaceFromItem(item); ok {
+			namespaces = append(namespaces, ns)
 		}
-		namespaces = append(namespaces, Namespace{Name: item.Metadata.Name})
 	}
 	return namespaces, nil
 }
 
-func parsePods(stdout string) ([]Pod, error) {
-	var list podList
-	if err := json.Unmarshal([]byte(stdout), &list); err != nil {
-		return nil, err
+// derivePodStatus mirrors the status column kubectl get pods prints,
+// walking init container states before regular container states and
+// falling back to Signal:<n>/ExitCode:<n> when a terminated container
+// didn't report a reason. See kubectl's printPod in
+// pkg/printers/internalversion/printers.go for the reference behavior.
+func derivePodStatus(item podItem) string {
+	status := item.Status.Phase
+	if item.Status.Reason != "" {
+		status = item.Status.Reason
 	}
-	pods := make([]Pod, 0, len(list.Items))
-	for _, item := range list.Items {
-		readyCount := 0
-		restarts := 0
-		status := item.Status.Phase
-		if item.Status.Reason != "" {
-			status = item.Status.Reason
+
+	initializing := false
+	for i, cs := range item.Status.InitContainerStatuses {
+		switch {
+		case cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 0:
+			continue
+		case cs.State.Terminated != nil:
+			status = "Init:" + terminationReason(*cs.State.Terminated)
+			initializing = true
+		case cs.State.Waiting != nil && cs.State.Waiting.Reason != "" && cs.State.Waiting.Reason != "PodInitializing":
+			status = "Init:" + cs.State.Waiting.Reason
+			initializing = true
+		default:
+			status = fmt.Sprintf("Init:%d/%d", i, len(item.Spec.InitContainers))
+			initializing = true
 		}
-		for _, cs := range item.Status.ContainerStatuses {
-			if cs.Ready {
-				readyCount++
-			}
-			restarts += cs.RestartCount
-			if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" {
+		break
+	}
+
+	if !initializing {
+		hasRunning := false
+		for i := len(item.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			cs := item.Status.ContainerStatuses[i]
+			switch {
+			case cs.State.Waiting != nil && cs.State.Waiting.Reason != "":
 				status = cs.State.Waiting.Reason
+			case cs.State.Terminated != nil:
+				status = terminationReason(*cs.State.Terminated)
+			case cs.Ready && cs.State.Running != nil:
+				hasRunning = true
 			}
-			if cs.State.Terminated != nil && cs.State.Terminated.Reason != "" {
-				status = cs.State.Terminated.Reason
-			}
 		}
-		total := len(item.Status.ContainerStatuses)
-		ready := fmt.Sprintf("%d/%d", readyCount, total)
-		age := "-"
-		if !item.Metadata.CreationTimestamp.IsZero() {
-			age = formatAge(time.Since(item.Metadata.CreationTimestamp))
+		if status == "Completed" && hasRunning {
+			status = "Running"
+		}
+	}
+
+	if item.Metadata.DeletionTimestamp != nil {
+		if item.Status.Reason == "NodeLost" {
+			status = "Unknown"
+		} else {
+			status = "Terminating"
 		}
-		node := item.Status.NodeName
-		if node == "" {
-			node = "-"
+	}
+
+	return status
+}
+
+// terminationReason formats a terminated container's state the way
+// kubectl does: its own Reason (e.g. "Completed", "Error") if it has
+// one, else a Signal:<n> or ExitCode:<n> fallback.
+func terminationReason(t struct {
+	Reason   string `json:"reason"`
+	ExitCode int    `json:"exitCode"`
+	Signal   int    `json:"signal"`
+}) string {
+	if t.Reason != "" {
+		return t.Reason
+	}
+	if t.Signal != 0 {
+		return fmt.Sprintf("Signal:%d", t.Signal)
+	}
+	return fmt.Sprintf("ExitCode:%d", t.ExitCode)
+}
+
+func podFromItem(item podItem) Pod {
+	readyCount := 0
+	restarts := 0
+	for _, cs := range item.Status.ContainerStatuses {
+		if cs.Ready {
+			readyCount++
 		}
-		pods = append(pods, Pod{
-			Name:     item.Metadata.Name,
-			Status:   status,
-			Ready:    ready,
-			Restarts: restarts,
-			Age:      age,
-			Node:     node,
-			HasOwner: len(item.Metadata.OwnerReferences) > 0,
-		})
+		restarts += cs.RestartCount
+	}
+	for _, cs := range item.Status.InitContainerStatuses {
+		restarts += cs.RestartCount
+	}
+	total := len(item.Status.ContainerStatuses)
+	ready := fmt.Sprintf("%d/%d", readyCount, total)
+	age := "-"
+	if !item.Metadata.CreationTimestamp.IsZero() {
+		age = formatAge(time.Since(item.Metadata.CreationTimestamp))
+	}
+	node := item.Status.NodeName
+	if node == "" {
+		node = "-"
+	}
+	return Pod{
+		Name:     item.Metadata.Name,
+		Status:   derivePodStatus(item),
+		Ready:    ready,
+		Restarts: restarts,
+		Age:      age,
+		Node:     node,
+		HasOwner: len(item.Metadata.OwnerReferences) > 0,
+	}
+}
+
+func parsePods(stdout string) ([]Pod, error) {
+	var list podList
+	if err := json.Unmarshal([]byte(stdout), &list); err != nil {
+		return nil, err
+	}
+	pods := make([]Pod, 0, len(list.Items))
+	for _, item := range list.Items {
+		pods = append(pods, podFromItem(item))
 	}
 	return pods, nil
 }
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// loadPodFixture decodes a pod JSON fixture under testdata/, captured
+// from a real cluster and trimmed down to a few metadata, spec and
+// status fields.
+func loadPodFixture(t *testing.T, name string) podItem {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+	var item podItem
+	if err := json.Unmarshal(raw, &item); err != nil {
+		t.Fatalf("unmarshal fixture %s: %v", name, err)
+	}
+	return item
+}
+
+func TestDerivePodStatus(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    string
+	}{
+		{"pod_crashloopbackoff.json", "CrashLoopBackOff"},
+		{"pod_imagepullbackoff.json", "ImagePullBackOff"},
+		{"pod_init_error.json", "Init:ExitCode:1"},
+		{"pod_job_completed.json", "Completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			item := loadPodFixture(t, tt.fixture)
+			if got := derivePodStatus(item); got != tt.want {
+				t.Errorf("derivePodStatus(%s) = %q, want %q", tt.fixture, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -59,6 +62,46 @@ func (r *KubectlRunner) Run(ctx context.Context, args []string, timeout time.Dur
 	}
 }
 
+// Stream runs kubectl with the given args and feeds each line of stdout
+// and stderr to the supplied callbacks as it is produced, rather than
+// buffering the whole output like Run does. It blocks until the command
+// exits or ctx is cancelled, so callers that want a long-lived stream
+// (e.g. `kubectl logs -f`) should invoke it from a goroutine with a
+// cancellable ctx.
+func (r *KubectlRunner) Stream(ctx context.Context, args []string, onStdout, onStderr func(line string)) error {
+	cmd := exec.CommandContext(ctx, r.binary, args...)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go scanLines(&wg, stdout, onStdout)
+	go scanLines(&wg, stderr, onStderr)
+	wg.Wait()
+
+	return cmd.Wait()
+}
+
+func scanLines(wg *sync.WaitGroup, r io.Reader, onLine func(line string)) {
+	defer wg.Done()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+}
+
 var (
 	dnsLabelRegex    = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
 	safeContextRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:@/-]*$`)
@@ -84,6 +127,23 @@ func validatePodName(name string) error {
 	return nil
 }
 
+func validateContainerName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("container name is required")
+	}
+	if len(name) > 253 || !dnsLabelRegex.MatchString(name) {
+		return fmt.Errorf("invalid container name")
+	}
+	return nil
+}
+
+func validatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("invalid port %d", port)
+	}
+	return nil
+}
+
 func validateContextName(name string) error {
 	if name == "" {
 		return nil
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,11 +12,7 @@ const (
 )
 
 func (a *App) runKubectl(args []string, timeout time.Duration) CommandResult {
-	ctx := a.ctx
-	if ctx == nil {
-		ctx = context.Background()
-	}
-	return a.runner.Run(ctx, args, timeout)
+	return a.runner.Run(a.context(), args, timeout)
 }
 
 func invalidResult(args []string, err error) CommandResult {
@@ -62,23 +57,27 @@ func (a *App) SetContext(name string) CommandResult {
 	args := []string{"config", "use-context", name}
 	result := a.runKubectl(args, defaultTimeout)
 	a.record(result)
+	if result.ExitCode == 0 {
+		// Watches started with contextName == "" track the kubeconfig's
+		// current-context implicitly, so leaving them running would have
+		// their next reconnect silently watch the new context instead.
+		// Watches pinned to an explicit --context are unaffected.
+		a.stopWatchesForContext("")
+		// Same implicit-current-context problem for ClientGoRunner's
+		// cached clientset: without this it would keep talking to the
+		// cluster that was current before this switch.
+		a.backend.ResetContext("")
+	}
 	return result
 }
 
+// ListNamespaces, ListPods, DeletePod, GetPodLogs and DescribePod below
+// validate their inputs and then delegate to a.backend, which is either
+// KubectlRunner or ClientGoRunner depending on how the backend was
+// selected (see selectBackend).
+
 func (a *App) ListNamespaces(contextName string) CommandResult {
-	args, err := withContext([]string{"get", "ns", "-o", "json"}, contextName)
-	if err != nil {
-		return invalidResult([]string{"--context", contextName, "get", "ns", "-o", "json"}, err)
-	}
-	result := a.runKubectl(args, defaultTimeout)
-	if result.ExitCode == 0 {
-		parsed, err := parseNamespaces(result.Stdout)
-		if err != nil {
-			appendParseError(&result, err)
-		} else {
-			result.ParsedData = parsed
-		}
-	}
+	result := a.backend.ListNamespaces(a.context(), contextName)
 	a.record(result)
 	return result
 }
@@ -87,19 +86,7 @@ func (a *App) ListPods(contextName, namespace string) CommandResult {
 	if err := validateNamespace(namespace); err != nil {
 		return invalidResult([]string{"get", "pods", "-n", namespace, "-o", "json"}, err)
 	}
-	args, err := withContext([]string{"get", "pods", "-n", namespace, "-o", "json"}, contextName)
-	if err != nil {
-		return invalidResult([]string{"--context", contextName, "get", "pods", "-n", namespace, "-o", "json"}, err)
-	}
-	result := a.runKubectl(args, defaultTimeout)
-	if result.ExitCode == 0 {
-		parsed, err := parsePods(result.Stdout)
-		if err != nil {
-			appendParseError(&result, err)
-		} else {
-			result.ParsedData = parsed
-		}
-	}
+	result := a.backend.ListPods(a.context(), contextName, namespace)
 	a.record(result)
 	return result
 }
@@ -111,30 +98,24 @@ func (a *App) DeletePod(contextName, namespace, name string) CommandResult {
 	if err := validatePodName(name); err != nil {
 		return invalidResult([]string{"delete", "pod", name, "-n", namespace}, err)
 	}
-	args, err := withContext([]string{"delete", "pod", name, "-n", namespace}, contextName)
-	if err != nil {
-		return invalidResult([]string{"--context", contextName, "delete", "pod", name, "-n", namespace}, err)
-	}
-	result := a.runKubectl(args, defaultTimeout)
+	result := a.backend.DeletePod(a.context(), contextName, namespace, name)
 	a.record(result)
 	return result
 }
 
-func (a *App) GetPodLogs(contextName, namespace, name string, tail int) CommandResult {
+func (a *App) GetPodLogs(contextName, namespace, name, container string, tail int) CommandResult {
 	if err := validateNamespace(namespace); err != nil {
 		return invalidResult([]string{"logs", name, "-n", namespace}, err)
 	}
 	if err := validatePodName(name); err != nil {
 		return invalidResult([]string{"logs", name, "-n", namespace}, err)
 	}
-	if tail <= 0 {
-		tail = 100
-	}
-	args, err := withContext([]string{"logs", name, "-n", namespace, fmt.Sprintf("--tail=%d", tail)}, contextName)
-	if err != nil {
-		return invalidResult([]string{"--context", contextName, "logs", name, "-n", namespace}, err)
+	if container != "" {
+		if err := validateContainerName(container); err != nil {
+			return invalidResult([]string{"logs", name, "-n", namespace, "-c", container}, err)
+		}
 	}
-	result := a.runKubectl(args, logsTimeout)
+	result := a.backend.GetPodLogs(a.context(), contextName, namespace, name, container, tail)
 	a.record(result)
 	return result
 }
@@ -146,11 +127,7 @@ func (a *App) DescribePod(contextName, namespace, name string) CommandResult {
 	if err := validatePodName(name); err != nil {
 		return invalidResult([]string{"describe", "pod", name, "-n", namespace}, err)
 	}
-	args, err := withContext([]string{"describe", "pod", name, "-n", namespace}, contextName)
-	if err != nil {
-		return invalidResult([]string{"--context", contextName, "describe", "pod", name, "-n", namespace}, err)
-	}
-	result := a.runKubectl(args, defaultTimeout)
+	result := a.backend.DescribePod(a.context(), contextName, namespace, name)
 	a.record(result)
 	return result
 }
@@ -22,3 +22,140 @@ type Pod struct {
 	Node     string `json:"node"`
 	HasOwner bool   `json:"hasOwner"`
 }
+
+// LogStreamOptions configures a StreamPodLogs call.
+type LogStreamOptions struct {
+	Container    string `json:"container,omitempty"`
+	SinceSeconds int    `json:"sinceSeconds,omitempty"`
+	SinceTime    string `json:"sinceTime,omitempty"`
+	Previous     bool   `json:"previous,omitempty"`
+}
+
+// LogStreamEvent is emitted over the Wails runtime for each line a log
+// stream produces.
+type LogStreamEvent struct {
+	StreamID string `json:"streamId"`
+	Line     string `json:"line"`
+	Stderr   bool   `json:"stderr,omitempty"`
+}
+
+// LogStreamEnded is emitted once when a log stream stops, whether because
+// the caller cancelled it or the underlying kubectl process exited.
+type LogStreamEnded struct {
+	StreamID string `json:"streamId"`
+	Err      string `json:"err,omitempty"`
+}
+
+// PodEvent is a single ADDED/MODIFIED/DELETED frame from a pod watch.
+type PodEvent struct {
+	EventType string `json:"eventType"`
+	Pod       Pod    `json:"pod"`
+}
+
+// NamespaceEvent is a single ADDED/MODIFIED/DELETED frame from a
+// namespace watch.
+type NamespaceEvent struct {
+	EventType string    `json:"eventType"`
+	Namespace Namespace `json:"namespace"`
+}
+
+// PortForwardEnded is emitted once when a port-forward tunnel dies,
+// whether because the caller stopped it or kubectl exited on its own.
+type PortForwardEnded struct {
+	SessionID string `json:"sessionId"`
+	Err       string `json:"err,omitempty"`
+}
+
+// ExecEnded is emitted once when an exec session's shell exits.
+type ExecEnded struct {
+	SessionID string `json:"sessionId"`
+	Err       string `json:"err,omitempty"`
+}
+
+// OwnerRef is one link in a pod's owner chain, e.g. ReplicaSet -> Deployment.
+type OwnerRef struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// ContainerResources holds a container's resource requests/limits as the
+// raw quantity strings kubectl would print (e.g. "500m", "256Mi").
+type ContainerResources struct {
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+type EnvVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+type VolumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// ContainerProbes summarizes a container's probes as short human-readable
+// strings (e.g. "httpGet :8080/healthz"), empty when not configured.
+type ContainerProbes struct {
+	Liveness  string `json:"liveness,omitempty"`
+	Readiness string `json:"readiness,omitempty"`
+	Startup   string `json:"startup,omitempty"`
+}
+
+type ContainerDetail struct {
+	Name         string             `json:"name"`
+	Image        string             `json:"image"`
+	Ready        bool               `json:"ready"`
+	RestartCount int                `json:"restartCount"`
+	Resources    ContainerResources `json:"resources"`
+	Env          []EnvVar           `json:"env,omitempty"`
+	VolumeMounts []VolumeMount      `json:"volumeMounts,omitempty"`
+	Probes       ContainerProbes    `json:"probes"`
+}
+
+// VolumeDetail describes a pod volume's name and the kind of source that
+// backs it (e.g. "configMap", "secret", "emptyDir").
+type VolumeDetail struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+type Toleration struct {
+	Key      string `json:"key,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Effect   string `json:"effect,omitempty"`
+}
+
+type PodCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// PodEventRecord is one entry in a pod's describe-style event history.
+type PodEventRecord struct {
+	LastSeen string `json:"lastSeen"`
+	Type     string `json:"type"`
+	Reason   string `json:"reason"`
+	Message  string `json:"message"`
+	Count    int    `json:"count"`
+}
+
+// PodDescription is the structured equivalent of `kubectl describe pod`,
+// populated into CommandResult.ParsedData by DescribePod.
+type PodDescription struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Node        string            `json:"node"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	OwnerChain  []OwnerRef        `json:"ownerChain,omitempty"`
+	Containers  []ContainerDetail `json:"containers"`
+	Volumes     []VolumeDetail    `json:"volumes,omitempty"`
+	Tolerations []Toleration      `json:"tolerations,omitempty"`
+	Conditions  []PodCondition    `json:"conditions,omitempty"`
+	Events      []PodEventRecord  `json:"events,omitempty"`
+}
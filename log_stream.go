@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// StreamPodLogs starts `kubectl logs -f` for the given pod/container and
+// emits each line as a "log-stream:<id>" runtime event, rather than
+// blocking until the command completes like GetPodLogs does. It returns
+// a stream ID the caller passes to StopLogStream to cancel it.
+func (a *App) StreamPodLogs(contextName, namespace, name string, opts LogStreamOptions) (string, error) {
+	if err := validateNamespace(namespace); err != nil {
+		return "", err
+	}
+	if err := validatePodName(name); err != nil {
+		return "", err
+	}
+	if opts.Container != "" {
+		if err := validateContainerName(opts.Container); err != nil {
+			return "", err
+		}
+	}
+
+	args := []string{"logs", name, "-n", namespace, "-f"}
+	if opts.Container != "" {
+		args = append(args, "-c", opts.Container)
+	}
+	if opts.Previous {
+		args = append(args, "--previous")
+	}
+	if opts.SinceTime != "" {
+		args = append(args, "--since-time="+opts.SinceTime)
+	} else if opts.SinceSeconds > 0 {
+		args = append(args, fmt.Sprintf("--since=%ds", opts.SinceSeconds))
+	}
+	args, err := withContext(args, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	streamCtx, cancel := context.WithCancel(a.context())
+
+	a.mu.Lock()
+	a.streamSeq++
+	id := fmt.Sprintf("log-%d", a.streamSeq)
+	a.logStreams[id] = cancel
+	a.mu.Unlock()
+
+	go a.runLogStream(streamCtx, id, args)
+
+	return id, nil
+}
+
+func (a *App) runLogStream(ctx context.Context, id string, args []string) {
+	err := a.runner.Stream(ctx, args,
+		func(line string) {
+			runtime.EventsEmit(a.ctx, "log-stream:"+id, LogStreamEvent{StreamID: id, Line: line})
+		},
+		func(line string) {
+			runtime.EventsEmit(a.ctx, "log-stream:"+id, LogStreamEvent{StreamID: id, Line: line, Stderr: true})
+		},
+	)
+
+	a.mu.Lock()
+	delete(a.logStreams, id)
+	a.mu.Unlock()
+
+	ended := LogStreamEnded{StreamID: id}
+	if err != nil {
+		ended.Err = err.Error()
+	}
+	runtime.EventsEmit(a.ctx, "log-stream:"+id+":done", ended)
+}
+
+// StopLogStream cancels a running log stream started by StreamPodLogs. It
+// is a no-op if the stream has already ended.
+func (a *App) StopLogStream(id string) {
+	a.mu.Lock()
+	cancel, ok := a.logStreams[id]
+	delete(a.logStreams, id)
+	a.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+}
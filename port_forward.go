@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type portForwardSession struct {
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+}
+
+// PortForward starts `kubectl port-forward` for pod and returns a
+// session ID. A "port-forward:<id>:ready" event is emitted once kubectl
+// prints its "Forwarding from ..." line, and "port-forward:<id>:ended"
+// once the tunnel goes down, whether from StopPortForward or kubectl
+// exiting on its own (e.g. the pod was deleted).
+func (a *App) PortForward(contextName, namespace, pod string, localPort, remotePort int) (string, error) {
+	if err := validateNamespace(namespace); err != nil {
+		return "", err
+	}
+	if err := validatePodName(pod); err != nil {
+		return "", err
+	}
+	if err := validatePort(localPort); err != nil {
+		return "", err
+	}
+	if err := validatePort(remotePort); err != nil {
+		return "", err
+	}
+
+	args, err := withContext([]string{"port-forward", "-n", namespace, "pod/" + pod, fmt.Sprintf("%d:%d", localPort, remotePort)}, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithCancel(a.context())
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		cancel()
+		return "", err
+	}
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return "", err
+	}
+
+	a.mu.Lock()
+	a.forwardSeq++
+	id := fmt.Sprintf("pf-%d", a.forwardSeq)
+	a.portForwards[id] = &portForwardSession{cmd: cmd, cancel: cancel}
+	a.mu.Unlock()
+
+	go a.watchPortForward(id, stdout, stderr, cmd)
+
+	return id, nil
+}
+
+// watchPortForward waits for kubectl's "Forwarding from ..." line, which
+// it prints to stdout (not stderr), to fire the ready event, then waits
+// for the process to exit to fire the ended event.
+func (a *App) watchPortForward(id string, stdout, stderr io.Reader, cmd *exec.Cmd) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	ready := false
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !ready && strings.Contains(line, "Forwarding from") {
+				ready = true
+				runtime.EventsEmit(a.ctx, "port-forward:"+id+":ready", line)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			// drained so kubectl never blocks on a full stderr pipe
+		}
+	}()
+	wg.Wait()
+
+	err := cmd.Wait()
+
+	a.mu.Lock()
+	delete(a.portForwards, id)
+	a.mu.Unlock()
+
+	ended := PortForwardEnded{SessionID: id}
+	if err != nil {
+		ended.Err = err.Error()
+	}
+	runtime.EventsEmit(a.ctx, "port-forward:"+id+":ended", ended)
+}
+
+// StopPortForward tears down a running port-forward tunnel. It is a
+// no-op if the session has already ended.
+func (a *App) StopPortForward(id string) {
+	a.mu.Lock()
+	sess, ok := a.portForwards[id]
+	delete(a.portForwards, id)
+	a.mu.Unlock()
+
+	if ok {
+		sess.cancel()
+	}
+}
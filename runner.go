@@ -0,0 +1,23 @@
+package main
+
+import "context"
+
+// Runner is the backend App talks to for cluster operations: listing
+// namespaces/pods, deleting a pod, fetching logs, and describing a pod.
+// KubectlRunner implements it by shelling out to the kubectl binary;
+// ClientGoRunner implements it by talking to the API server directly via
+// client-go. Both report results through the same CommandResult
+// transport so the transcript view works regardless of backend.
+type Runner interface {
+	ListNamespaces(ctx context.Context, contextName string) CommandResult
+	ListPods(ctx context.Context, contextName, namespace string) CommandResult
+	DeletePod(ctx context.Context, contextName, namespace, name string) CommandResult
+	GetPodLogs(ctx context.Context, contextName, namespace, name, container string, tail int) CommandResult
+	DescribePod(ctx context.Context, contextName, namespace, name string) CommandResult
+
+	// ResetContext discards any cached state keyed by contextName so the
+	// next call for it picks up a kubeconfig change instead of reusing a
+	// stale connection. KubectlRunner has no such cache and treats this
+	// as a no-op; ClientGoRunner uses it to evict a cached clientset.
+	ResetContext(contextName string)
+}
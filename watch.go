@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const (
+	watchCoalesceWindow  = 200 * time.Millisecond
+	watchMinBackoff      = time.Second
+	watchMaxBackoff      = 30 * time.Second
+	watchStableRunPeriod = 10 * time.Second
+)
+
+type watchFrame struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// watchHandle tracks a running watch's cancel func alongside the
+// contextName it was started with, so SetContext can tear down the
+// watches left implicitly pointed at the kubeconfig's current-context
+// without touching ones pinned to an explicit context.
+type watchHandle struct {
+	contextName string
+	cancel      context.CancelFunc
+}
+
+// WatchPods starts a `kubectl get pods -w` watch for namespace and emits
+// each ADDED/MODIFIED/DELETED frame as a "pod-watch:<id>" runtime event.
+// A burst of MODIFIED events for the same pod within watchCoalesceWindow
+// collapses into the most recent one so the UI table doesn't thrash.
+func (a *App) WatchPods(contextName, namespace string) (string, error) {
+	if err := validateNamespace(namespace); err != nil {
+		return "", err
+	}
+	args, err := withContext([]string{"get", "pods", "-n", namespace, "-w", "-o", "json", "--output-watch-events"}, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	id := a.startWatch(args, func(line string, emit func(key string, coalesce bool, event interface{})) {
+		var frame watchFrame
+		if err := json.Unmarshal([]byte(line), &frame); err != nil {
+			return
+		}
+		var item podItem
+		if err := json.Unmarshal(frame.Object, &item); err != nil {
+			return
+		}
+		pod := podFromItem(item)
+		event := PodEvent{EventType: frame.Type, Pod: pod}
+		emit(pod.Name, frame.Type == "MODIFIED", event)
+	}, "pod-watch:", contextName)
+
+	return id, nil
+}
+
+// WatchNamespaces starts a `kubectl get ns -w` watch and emits each frame
+// as a "namespace-watch:<id>" runtime event.
+func (a *App) WatchNamespaces(contextName string) (string, error) {
+	args, err := withContext([]string{"get", "ns", "-w", "-o", "json", "--output-watch-events"}, contextName)
+	if err != nil {
+		return "", err
+	}
+
+	id := a.startWatch(args, func(line string, emit func(key string, coalesce bool, event interface{})) {
+		var frame watchFrame
+		if err := json.Unmarshal([]byte(line), &frame); err != nil {
+			return
+		}
+		var item namespaceItem
+		if err := json.Unmarshal(frame.Object, &item); err != nil {
+			return
+		}
+		ns, ok := namespaceFromItem(item)
+		if !ok {
+			return
+		}
+		event := NamespaceEvent{EventType: frame.Type, Namespace: ns}
+		emit(ns.Name, frame.Type == "MODIFIED", event)
+	}, "namespace-watch:", contextName)
+
+	return id, nil
+}
+
+// StopWatch tears down a watch started by WatchPods or WatchNamespaces.
+func (a *App) StopWatch(id string) {
+	a.mu.Lock()
+	handle, ok := a.watches[id]
+	delete(a.watches, id)
+	a.mu.Unlock()
+
+	if ok {
+		handle.cancel()
+	}
+}
+
+// stopWatchesForContext tears down every running watch started with the
+// given contextName, e.g. the "" implicit-current-context watches that
+// SetContext leaves silently pointed at a different cluster otherwise.
+// Watches pinned to an explicit context are left running.
+func (a *App) stopWatchesForContext(contextName string) {
+	a.mu.Lock()
+	var toCancel []context.CancelFunc
+	for id, handle := range a.watches {
+		if handle.contextName != contextName {
+			continue
+		}
+		toCancel = append(toCancel, handle.cancel)
+		delete(a.watches, id)
+	}
+	a.mu.Unlock()
+
+	for _, cancel := range toCancel {
+		cancel()
+	}
+}
+
+// startWatch wires a kubectl watch command into the per-frame parser fn,
+// which reports each decoded event through emit. It returns the watch ID
+// and runs the reconnect loop in the background.
+func (a *App) startWatch(args []string, parse func(line string, emit func(key string, coalesce bool, event interface{})), eventPrefix, contextName string) string {
+	ctx, cancel := context.WithCancel(a.context())
+
+	a.mu.Lock()
+	a.watchSeq++
+	id := fmt.Sprintf("watch-%d", a.watchSeq)
+	a.watches[id] = watchHandle{contextName: contextName, cancel: cancel}
+	a.mu.Unlock()
+
+	emitter := newCoalescingEmitter(watchCoalesceWindow)
+	emit := func(key string, coalesce bool, event interface{}) {
+		publish := func() { runtime.EventsEmit(a.ctx, eventPrefix+id, event) }
+		if coalesce {
+			emitter.schedule(key, publish)
+		} else {
+			emitter.cancel(key)
+			publish()
+		}
+	}
+
+	go a.runWatch(ctx, id, args, func(line string) { parse(line, emit) })
+
+	return id
+}
+
+// runWatch runs the kubectl watch command, reconnecting with exponential
+// backoff whenever it exits unexpectedly, until ctx is cancelled.
+func (a *App) runWatch(ctx context.Context, id string, args []string, onLine func(string)) {
+	backoff := watchMinBackoff
+	for {
+		started := time.Now()
+		err := a.runner.Stream(ctx, args, onLine, func(string) {})
+		if ctx.Err() != nil {
+			break
+		}
+
+		if time.Since(started) >= watchStableRunPeriod {
+			backoff = watchMinBackoff
+		}
+		runtime.EventsEmit(a.ctx, "watch:"+id+":reconnecting", watchReconnect(id, err, backoff))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > watchMaxBackoff {
+			backoff = watchMaxBackoff
+		}
+	}
+
+	a.mu.Lock()
+	delete(a.watches, id)
+	a.mu.Unlock()
+}
+
+func watchReconnect(id string, err error, nextDelay time.Duration) map[string]interface{} {
+	event := map[string]interface{}{
+		"watchId":     id,
+		"nextDelayMs": nextDelay.Milliseconds(),
+	}
+	if err != nil {
+		event["err"] = err.Error()
+	}
+	return event
+}
+
+// coalescingEmitter collapses a burst of scheduled callbacks for the same
+// key into a single call once the window elapses, used to avoid flooding
+// the UI with rapid-fire MODIFIED watch events for the same object.
+type coalescingEmitter struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending map[string]func()
+	timer   *time.Timer
+}
+
+func newCoalescingEmitter(window time.Duration) *coalescingEmitter {
+	return &coalescingEmitter{window: window, pending: make(map[string]func())}
+}
+
+func (c *coalescingEmitter) schedule(key string, fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[key] = fn
+	if c.timer == nil {
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+}
+
+// cancel drops any pending callback for key without running it, used when
+// a later ADDED/DELETED event supersedes a scheduled MODIFIED.
+func (c *coalescingEmitter) cancel(key string) {
+	c.mu.Lock()
+	delete(c.pending, key)
+	c.mu.Unlock()
+}
+
+func (c *coalescingEmitter) flush() {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[string]func())
+	c.timer = nil
+	c.mu.Unlock()
+	for _, fn := range pending {
+		fn()
+	}
+}